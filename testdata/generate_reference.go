@@ -1,7 +1,36 @@
 // +build ignore
 
-// This program generates reference JSON output from Go gnostic-models
-// for comparison with the Rust implementation.
+// This program generates reference JSON output from the upstream Go
+// gnostic-models library for comparison with this crate's Rust
+// implementation. Its output — petstore-v3-reference.json,
+// petstore-v2-reference.json, and books-discovery-reference.json — is
+// committed alongside the fixtures it was generated from, and is read by
+// the `matches_go_reference_output` tests in openapiv3::mod::tests,
+// openapiv2::mod::tests, and discovery::mod::tests; those tests don't
+// invoke Go themselves; they check this crate's parsed `Document`s against
+// the committed protojson, so they run without a Go toolchain present.
+//
+// To regenerate after changing a fixture, run (from this directory, with a
+// Go toolchain and network access to fetch the two modules below):
+//
+//	mkdir -p /tmp/gnostic-ref
+//	cat > /tmp/gnostic-ref/go.mod <<-EOF
+//	module gnostic-ref
+//	go 1.21
+//	require (
+//		github.com/google/gnostic-models v0.6.9
+//		google.golang.org/protobuf v1.34.2
+//	)
+//	EOF
+//	cp generate_reference.go /tmp/gnostic-ref/main.go
+//	out=$(pwd -P)
+//	(cd /tmp/gnostic-ref && go mod download && go run main.go "$out")
+//
+// (the explicit `go.mod` pins are needed because gnostic-models' and
+// protobuf's latest releases require a newer Go than this repo otherwise
+// assumes; `go run main.go` — naming the file directly rather than letting
+// `go build ./...` discover it — is what lets the `// +build ignore` tag
+// above keep this file out of any real build.)
 package main
 
 import (